@@ -0,0 +1,64 @@
+// Command awesome bundles the runnable examples in this repo behind a
+// single binary with go-tool-style subcommands.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Shad0wHunt3rs/awesome-GO/config"
+)
+
+// version is set at build time via -ldflags "-X main.version=...".
+var version = "dev"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "--version", "-version":
+		fmt.Println(version)
+	case "hello":
+		runHello(os.Args[2:])
+	case "config":
+		runConfig(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+// runHello implements `awesome hello [--name X]`.
+func runHello(args []string) {
+	fs := flag.NewFlagSet("hello", flag.ExitOnError)
+	name := fs.String("name", "World", "name to greet")
+	fs.Parse(args)
+
+	fmt.Printf("Hello %s!\n", *name)
+}
+
+// runConfig implements `awesome config [--path config.json]`.
+func runConfig(args []string) {
+	fs := flag.NewFlagSet("config", flag.ExitOnError)
+	path := fs.String("path", "config.json", "path to config file")
+	fs.Parse(args)
+
+	cfg, err := config.Load(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("%+v\n", cfg)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: awesome <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  hello    print a greeting")
+	fmt.Fprintln(os.Stderr, "  config   load and print a config file")
+	fmt.Fprintln(os.Stderr, "  --version   print the build version")
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// binPath is set by TestMain to the freshly built awesome binary.
+var binPath string
+
+// TestMain builds `awesome` once via `go build -ldflags "-X main.version=..."`
+// so the subcommand tests below can exec the real binary via os/exec.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "awesome-bin")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+
+	binPath = filepath.Join(dir, "awesome")
+	build := exec.Command("go", "build", "-ldflags", "-X main.version=test-version", "-o", binPath, ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		panic("go build failed: " + err.Error() + "\n" + string(out))
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestAwesome(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantStdout string
+	}{
+		{
+			name:       "hello default",
+			args:       []string{"hello"},
+			wantStdout: "Hello World!\n",
+		},
+		{
+			name:       "hello with name",
+			args:       []string{"hello", "--name", "Gopher"},
+			wantStdout: "Hello Gopher!\n",
+		},
+		{
+			name:       "version",
+			args:       []string{"--version"},
+			wantStdout: "test-version\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command(binPath, tt.args...)
+			out, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("running %v: %v", tt.args, err)
+			}
+			if got := string(out); got != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", got, tt.wantStdout)
+			}
+		})
+	}
+}
+
+func TestAwesome_Config(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"port": 9090, "environment": "dev"}`), 0o644); err != nil {
+		t.Fatalf("writing test config: %v", err)
+	}
+
+	cmd := exec.Command(binPath, "config", "--path", path)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("running config subcommand: %v", err)
+	}
+	if !strings.Contains(string(out), "Port:9090") {
+		t.Errorf("stdout = %q, want it to contain %q", out, "Port:9090")
+	}
+}
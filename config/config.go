@@ -0,0 +1,131 @@
+// Package config loads the application's configuration from a JSON file,
+// applying struct-tag defaults and environment variable overrides on top.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+// Config holds the settings the application needs to start up. Fields are
+// tagged with `default` for their fallback value and `env` for the
+// environment variable that overrides it, so a container can run the same
+// config.json everywhere and only override what differs per environment.
+// A field tagged `required:"true"` must not have a `default`: it has to be
+// set explicitly by the file or the environment, or Load fails.
+type Config struct {
+	Port        int    `json:"port" default:"8080" env:"APP_PORT"`
+	Name        string `json:"name" default:"awesome-app" env:"APP_NAME"`
+	Environment string `json:"environment" env:"APP_ENV" required:"true"`
+}
+
+// Load reads the config file at path, applies defaults, decodes the JSON
+// on top of them, then overlays any set environment variables. It rejects
+// unknown JSON fields so typos in config.json fail loudly instead of being
+// silently ignored.
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+	if err := applyDefaults(cfg); err != nil {
+		return nil, fmt.Errorf("applying defaults: %w", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening config file: %w", err)
+	}
+	defer file.Close()
+
+	dec := json.NewDecoder(file)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("decoding config file: %w", err)
+	}
+
+	if err := applyEnv(cfg); err != nil {
+		return nil, fmt.Errorf("applying env overrides: %w", err)
+	}
+
+	if err := validate(cfg); err != nil {
+		return nil, fmt.Errorf("validating config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// applyDefaults populates each field from its `default` struct tag before
+// the JSON is decoded, so any field the file doesn't set still ends up
+// with a sane value.
+func applyDefaults(cfg *Config) error {
+	return forEachTaggedField(cfg, "default", func(field reflect.Value, tag string) error {
+		return setFieldFromString(field, tag)
+	})
+}
+
+// applyEnv overlays environment variables named by each field's `env`
+// struct tag, so it always wins over both the default and the file value
+// when set.
+func applyEnv(cfg *Config) error {
+	return forEachTaggedField(cfg, "env", func(field reflect.Value, tag string) error {
+		val, ok := os.LookupEnv(tag)
+		if !ok {
+			return nil
+		}
+		return setFieldFromString(field, val)
+	})
+}
+
+// validate checks that every field marked `required:"true"` ended up with
+// a non-zero value. It only makes sense for fields with no `default` tag,
+// since a defaulted field is zero by the time this runs only if the file or
+// the environment explicitly set it to its zero value.
+func validate(cfg *Config) error {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("required") != "true" {
+			continue
+		}
+		if v.Field(i).IsZero() {
+			return fmt.Errorf("field %q is required", t.Field(i).Name)
+		}
+	}
+	return nil
+}
+
+// forEachTaggedField walks the fields of cfg and invokes fn for each one
+// that carries a non-empty value for tagName.
+func forEachTaggedField(cfg *Config, tagName string, fn func(field reflect.Value, tag string) error) error {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get(tagName)
+		if tag == "" {
+			continue
+		}
+		if err := fn(v.Field(i), tag); err != nil {
+			return fmt.Errorf("field %q: %w", t.Field(i).Name, err)
+		}
+	}
+	return nil
+}
+
+// setFieldFromString assigns value to field, converting it to match the
+// field's kind. It only supports the kinds Config actually uses.
+func setFieldFromString(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parsing %q as int: %w", value, err)
+		}
+		field.SetInt(n)
+	default:
+		return fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+	return nil
+}
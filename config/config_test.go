@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, dir, contents string) string {
+	t.Helper()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing test config: %v", err)
+	}
+	return path
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "does-not-exist.json"))
+	if err == nil {
+		t.Fatal("expected error for missing file, got nil")
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected wrapped os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestLoad_MalformedJSON(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `{"port": 8080,`)
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected error for malformed JSON, got nil")
+	}
+}
+
+func TestLoad_UnknownFieldRejected(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `{"port": 8080, "name": "svc", "timeout": 30}`)
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected error for unknown field, got nil")
+	}
+}
+
+func TestLoad_DefaultsPopulated(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `{"environment": "dev"}`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Port != 8080 {
+		t.Errorf("Port = %d, want default 8080", cfg.Port)
+	}
+	if cfg.Name != "awesome-app" {
+		t.Errorf("Name = %q, want default %q", cfg.Name, "awesome-app")
+	}
+}
+
+func TestLoad_EnvOverridesFileAndDefault(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `{"port": 9090, "environment": "dev"}`)
+	t.Setenv("APP_PORT", "9999")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Port != 9999 {
+		t.Errorf("Port = %d, want env override 9999", cfg.Port)
+	}
+}
+
+func TestLoad_MissingRequiredField(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `{"port": 9090}`)
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected error for missing required field, got nil")
+	}
+}
+
+func TestLoad_RequiredFieldFromEnv(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `{"port": 9090}`)
+	t.Setenv("APP_ENV", "staging")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Environment != "staging" {
+		t.Errorf("Environment = %q, want %q", cfg.Environment, "staging")
+	}
+}